@@ -0,0 +1,110 @@
+package crontab
+
+import (
+	"sync"
+	"time"
+)
+
+// BackoffPolicy governs how long a job is paused after consecutive failures
+//
+// The pause grows as Base * 2^(failCount-1), capped at Max. The zero value
+// uses a Base of one minute and a Max of one hour, i.e. 1m, 2m, 4m, ..., 1h.
+type BackoffPolicy struct {
+	Base time.Duration
+	Max  time.Duration
+}
+
+// Backoff returns how long a job should stay paused after failCount
+// consecutive failures
+func (p BackoffPolicy) Backoff(failCount int) time.Duration {
+	base := p.Base
+	if base <= 0 {
+		base = time.Minute
+	}
+	max := p.Max
+	if max <= 0 {
+		max = time.Hour
+	}
+
+	d := base
+	for i := 1; i < failCount; i++ {
+		d *= 2
+		if d >= max {
+			return max
+		}
+	}
+	if d > max {
+		d = max
+	}
+	return d
+}
+
+// JobStatus reports a job's failure and backoff state, for operators to
+// observe or override with (*Crontab).Pause and (*Crontab).Resume
+type JobStatus struct {
+	Name        string
+	FailCount   int
+	LastErr     error
+	PausedUntil time.Time
+}
+
+// jobState holds the mutable failure/pause bookkeeping for a single job. It
+// is kept behind a pointer so Job stays cheap to pass and copy by value.
+type jobState struct {
+	mu        sync.Mutex
+	failCount int
+	lastErr   error
+	pausedAt  time.Time
+}
+
+// pausedUntil reports whether the job should sit out the given instant
+func (s *jobState) pausedUntil(now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return now.Before(s.pausedAt)
+}
+
+// pause forces the job to sit out until the given instant, independent of
+// any backoff bookkeeping
+func (s *jobState) pause(until time.Time) {
+	s.mu.Lock()
+	s.pausedAt = until
+	s.mu.Unlock()
+}
+
+// reset clears the failure count and any pause, as if the job never failed
+func (s *jobState) reset() {
+	s.mu.Lock()
+	s.failCount = 0
+	s.lastErr = nil
+	s.pausedAt = time.Time{}
+	s.mu.Unlock()
+}
+
+// recordResult applies policy's backoff after a failure, or clears it after success
+func (s *jobState) recordResult(policy BackoffPolicy, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lastErr = err
+	if err == nil {
+		s.failCount = 0
+		s.pausedAt = time.Time{}
+		return
+	}
+
+	s.failCount++
+	s.pausedAt = time.Now().Add(policy.Backoff(s.failCount))
+}
+
+// status snapshots the current failure/pause state as a JobStatus
+func (s *jobState) status(name string) JobStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return JobStatus{
+		Name:        name,
+		FailCount:   s.failCount,
+		LastErr:     s.lastErr,
+		PausedUntil: s.pausedAt,
+	}
+}
@@ -0,0 +1,122 @@
+package crontab_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shved/crontab"
+)
+
+func TestErrorAwareJobPausesOnFailure(t *testing.T) {
+	ctab := crontab.New()
+	ctab.Start()
+
+	opts := crontab.Options{Backoff: crontab.BackoffPolicy{Base: time.Hour}}
+	if err := ctab.AddJobWithOptions("* * * * *", "failing", func() error {
+		return errors.New("boom")
+	}, opts); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ctab.Run("failing"); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	status, err := ctab.Status("failing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.FailCount != 1 {
+		t.Error("expected FailCount 1 after a failing run, got", status.FailCount)
+	}
+	if status.LastErr == nil || status.LastErr.Error() != "boom" {
+		t.Error("expected LastErr to be the job's error, got", status.LastErr)
+	}
+	if !status.PausedUntil.After(time.Now()) {
+		t.Error("expected the job to be paused after a failure, got", status.PausedUntil)
+	}
+
+	if err := ctab.Resume("failing"); err != nil {
+		t.Fatal(err)
+	}
+	status, err = ctab.Status("failing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.FailCount != 0 || status.PausedUntil.After(time.Now()) {
+		t.Error("expected Resume to clear the backoff, got", status)
+	}
+
+	if err := ctab.Pause("failing", time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	status, err = ctab.Status("failing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !status.PausedUntil.After(time.Now()) {
+		t.Error("expected Pause to take effect, got", status.PausedUntil)
+	}
+
+	if _, err := ctab.Status("missing_job"); err == nil {
+		t.Error("Status() on missing job should return an error")
+	}
+
+	ctab.Shutdown()
+}
+
+func TestErrorAwareJobResetsOnSuccess(t *testing.T) {
+	ctab := crontab.New()
+	ctab.Start()
+
+	calls := 0
+	if err := ctab.AddJob("* * * * *", "flaky", func() error {
+		calls++
+		if calls == 1 {
+			return errors.New("first call fails")
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ctab.Run("flaky"); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	if err := ctab.Resume("flaky"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctab.Run("flaky"); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	status, err := ctab.Status("flaky")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.FailCount != 0 || status.LastErr != nil {
+		t.Error("expected a successful run to reset the backoff state, got", status)
+	}
+
+	ctab.Shutdown()
+}
+
+func TestAddJobRejectsBadSignature(t *testing.T) {
+	ctab := crontab.New()
+	ctab.Start()
+
+	if err := ctab.AddJob("* * * * *", "two-returns", func() (int, error) { return 0, nil }); err == nil {
+		t.Error("AddJob should reject a func with more than one return value")
+	}
+
+	if err := ctab.AddJob("* * * * *", "wrong-return", func() int { return 0 }); err == nil {
+		t.Error("AddJob should reject a single non-error return value")
+	}
+
+	ctab.Shutdown()
+}
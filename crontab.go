@@ -3,36 +3,74 @@ package crontab
 
 import (
 	"fmt"
-	"log"
 	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
 // Crontab struct representing cron table
 type Crontab struct {
+	mu sync.Mutex
+
 	ticker *time.Ticker
-	loc    *time.Location
-	Jobs   []Job
+	period time.Duration
+
+	// tickerChanged is closed and replaced every time ticker is swapped out,
+	// so Start's loop can wake up and pick up the new one instead of staying
+	// parked on a ticker.C that will never receive again, see Start
+	tickerChanged chan struct{}
+
+	loc      *time.Location
+	wrappers []JobWrapper
+	env      map[string]string
+	Jobs     []Job
 }
 
 // Job in cron table
 type Job struct {
 	Name      string
+	Schedule  string
+	Prev      time.Time
+	sec       map[int]struct{}
 	min       map[int]struct{}
 	hour      map[int]struct{}
 	day       map[int]struct{}
 	month     map[int]struct{}
 	dayOfWeek map[int]struct{}
 
+	// every and nextAt drive `@every <duration>` schedules, an alternative
+	// to the field-based fields above
+	every  time.Duration
+	nextAt time.Time
+
+	// invoke is baseFunc() composed with the job's wrapper chain; run calls
+	// through it instead of reflecting on Fn directly
+	invoke func()
+
+	// backoff and state track failures for error-aware jobs, see AddJobWithOptions
+	backoff BackoffPolicy
+	state   *jobState
+
 	Fn   interface{}
 	args []interface{}
 }
 
-// tick is individual tick that occures each minute
+// Entry describes a registered job along with its schedule and timing
+// metadata, handy for admin dashboards and "when will this run?" endpoints
+type Entry struct {
+	Name     string
+	Schedule string
+	Next     time.Time
+	Prev     time.Time
+}
+
+// tick is individual tick that occures each minute, or each second for jobs
+// that need sub-minute resolution
 type tick struct {
+	sec       int
 	min       int
 	hour      int
 	day       int
@@ -52,29 +90,61 @@ func NewWithLocation(loc *time.Location) *Crontab {
 
 func newTabWithLocation(t time.Duration, l *time.Location) *Crontab {
 	return &Crontab{
-		ticker: time.NewTicker(t),
-		loc:    l,
+		ticker:        time.NewTicker(t),
+		period:        t,
+		tickerChanged: make(chan struct{}),
+		loc:           l,
 	}
 }
 
 // newTab creates new crontab, arg provided for testing purpose
 func newTab(t time.Duration) *Crontab {
 	return &Crontab{
-		ticker: time.NewTicker(t),
-		loc:    time.Local,
+		ticker:        time.NewTicker(t),
+		period:        t,
+		tickerChanged: make(chan struct{}),
+		loc:           time.Local,
 	}
 }
 
 // Start runs the crontab timer
 func (c *Crontab) Start() {
 	go func() {
-		for t := range c.ticker.C {
-			t = t.In(c.loc)
-			c.runScheduled(t)
+		for {
+			c.mu.Lock()
+			ticker := c.ticker
+			changed := c.tickerChanged
+			c.mu.Unlock()
+
+			select {
+			case t, ok := <-ticker.C:
+				if !ok {
+					return
+				}
+				c.runScheduled(t.In(c.loc))
+			case <-changed:
+				// ticker was swapped out (e.g. a new job needs a finer
+				// resolution); loop around and wait on the new one instead
+			}
 		}
 	}()
 }
 
+// resolvePeriod returns the ticker period the currently registered jobs
+// require: one second if any job uses a seconds field or an `@every`
+// shorter than a minute, one minute otherwise
+func resolvePeriod(jobs []Job) time.Duration {
+	for _, j := range jobs {
+		if len(j.sec) > 0 {
+			return time.Second
+		}
+		if j.every > 0 && j.every < time.Minute {
+			return time.Second
+		}
+	}
+	return time.Minute
+}
+
 // AddJob to cron table
 //
 // Returns error if:
@@ -85,6 +155,41 @@ func (c *Crontab) Start() {
 //
 // * Provided args don't match the number and/or the type of fn args
 func (c *Crontab) AddJob(schedule string, name string, fn interface{}, args ...interface{}) error {
+	return c.AddJobWithOptions(schedule, name, fn, Options{}, args...)
+}
+
+// Options configure how a single job is added via AddJobWithOptions
+type Options struct {
+	// Wrappers are applied to this job only, outermost first, nested inside
+	// the wrappers registered crontab-wide through Use
+	Wrappers []JobWrapper
+
+	// Backoff governs how long a job is paused after its fn returns an
+	// error or panics. Zero value uses BackoffPolicy's defaults.
+	Backoff BackoffPolicy
+}
+
+// errType is the interface fn's trailing return value must implement to
+// make a job error-aware, see AddJobWithOptions
+var errType = reflect.TypeOf((*error)(nil)).Elem()
+
+// AddJobWithOptions is like AddJob but lets the caller attach per-job
+// JobWrappers and a backoff policy
+//
+// Returns error if:
+//
+// * Cron syntax can't be parsed or out of bounds
+//
+// * fn is not function
+//
+// * Provided args don't match the number and/or the type of fn args
+//
+// * fn returns more than one value, or a single value that isn't error
+//
+// If fn's only return value is error, a non-nil error (or a panic) pauses
+// the job for a backoff interval that grows with consecutive failures; a
+// nil error resets the backoff. See BackoffPolicy, Pause, Resume and Status.
+func (c *Crontab) AddJobWithOptions(schedule string, name string, fn interface{}, opts Options, args ...interface{}) error {
 	j, err := parseSchedule(schedule)
 	if err != nil {
 		return fmt.Errorf("parsing schedule: %v", err)
@@ -120,14 +225,53 @@ func (c *Crontab) AddJob(schedule string, name string, fn interface{}, args ...i
 		}
 	}
 
+	switch fnType.NumOut() {
+	case 0:
+	case 1:
+		if !fnType.Out(0).Implements(errType) {
+			return fmt.Errorf("cron job with a return value must return error, got %s", fnType.Out(0))
+		}
+	default:
+		return fmt.Errorf("cron job must return nothing or a single error, got %d return values", fnType.NumOut())
+	}
+
 	// all checked, add job to cron tab
 	j.Fn = fn
 	j.Name = name
+	j.Schedule = schedule
 	j.args = args
+	j.backoff = opts.Backoff
+	j.state = &jobState{}
+	if j.every > 0 {
+		j.nextAt = time.Now().Add(j.every)
+	}
+
+	c.mu.Lock()
+	chain := NewChain(append(append([]JobWrapper{}, c.wrappers...), opts.Wrappers...)...)
+	j.invoke = chain.Then(j.baseFunc())
 	c.Jobs = append(c.Jobs, j)
+	if period := resolvePeriod(c.Jobs); period != c.period {
+		c.ticker.Stop()
+		c.ticker = time.NewTicker(period)
+		c.period = period
+		close(c.tickerChanged)
+		c.tickerChanged = make(chan struct{})
+	}
+	c.mu.Unlock()
+
 	return nil
 }
 
+// Use registers wrappers applied to every job's invocation, outermost first
+//
+// Use must be called before the jobs that should pick them up are added;
+// it has no effect on jobs already registered
+func (c *Crontab) Use(wrappers ...JobWrapper) {
+	c.mu.Lock()
+	c.wrappers = append(c.wrappers, wrappers...)
+	c.mu.Unlock()
+}
+
 // MustAddJob is like AddJob but panics if there is an problem with job
 //
 // It simplifies initialization, since we usually add jobs at the beggining so you won't have to check for errors (it will panic when program starts).
@@ -150,7 +294,9 @@ func (c *Crontab) MustAddJob(schedule string, name string, fn interface{}, args
 // Once stopped, it can't be restarted.
 // This function is pre-shuttdown helper for your app, there is no Start/Stop functionallity with crontab package.
 func (c *Crontab) Shutdown() {
+	c.mu.Lock()
 	c.ticker.Stop()
+	c.mu.Unlock()
 }
 
 // Clear all jobs from cron table
@@ -160,8 +306,8 @@ func (c *Crontab) Clear() {
 
 // RunAll jobs in cron table, scheduled or not
 func (c *Crontab) RunAll() {
-	for _, j := range c.Jobs {
-		go j.run()
+	for i := range c.Jobs {
+		go c.Jobs[i].run()
 	}
 }
 
@@ -177,9 +323,34 @@ func (c *Crontab) List() []string {
 
 // Run calls the job immediately
 func (c *Crontab) Run(name string) error {
-	for _, j := range c.Jobs {
-		if j.Name == name {
-			go j.run()
+	for i := range c.Jobs {
+		if c.Jobs[i].Name == name {
+			go c.Jobs[i].run()
+			return nil
+		}
+	}
+
+	return fmt.Errorf("job %s not found", name)
+}
+
+// Pause prevents the named job from firing on its schedule until the given instant
+func (c *Crontab) Pause(name string, until time.Time) error {
+	for i := range c.Jobs {
+		if c.Jobs[i].Name == name {
+			c.Jobs[i].state.pause(until)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("job %s not found", name)
+}
+
+// Resume clears any pause or accumulated backoff on the named job,
+// making it eligible to fire on its schedule again
+func (c *Crontab) Resume(name string) error {
+	for i := range c.Jobs {
+		if c.Jobs[i].Name == name {
+			c.Jobs[i].state.reset()
 			return nil
 		}
 	}
@@ -187,34 +358,149 @@ func (c *Crontab) Run(name string) error {
 	return fmt.Errorf("job %s not found", name)
 }
 
+// Status returns the named job's failure and backoff state
+func (c *Crontab) Status(name string) (JobStatus, error) {
+	for i := range c.Jobs {
+		if c.Jobs[i].Name == name {
+			j := &c.Jobs[i]
+			return j.state.status(j.Name), nil
+		}
+	}
+
+	return JobStatus{}, fmt.Errorf("job %s not found", name)
+}
+
 // RunScheduled jobs
 func (c *Crontab) runScheduled(t time.Time) {
-	tick := getTick(t)
-	for _, j := range c.Jobs {
-		if j.tick(tick) {
+	tk := getTick(t)
+	for i := range c.Jobs {
+		j := &c.Jobs[i]
+		if j.tick(tk, t) {
+			j.Prev = t
 			go j.run()
 		}
 	}
 }
 
-// run the job using reflection
-// Recover from panic although all functions and params are checked by AddJob, but you never know.
-func (j Job) run() {
-	defer func() {
-		if r := recover(); r != nil {
-			log.Printf("running crontab job: %s: %v", j.Name, r)
+// Next returns the next time the named job is scheduled to fire, relative to now
+func (c *Crontab) Next(name string) (time.Time, error) {
+	for i := range c.Jobs {
+		if c.Jobs[i].Name == name {
+			return c.Jobs[i].Next(time.Now().In(c.loc)), nil
 		}
-	}()
-	v := reflect.ValueOf(j.Fn)
-	rargs := make([]reflect.Value, len(j.args))
-	for i, a := range j.args {
-		rargs[i] = reflect.ValueOf(a)
 	}
-	v.Call(rargs)
+
+	return time.Time{}, fmt.Errorf("job %s not found", name)
+}
+
+// NextN returns the next n times the named job is scheduled to fire, relative to now
+func (c *Crontab) NextN(name string, n int) ([]time.Time, error) {
+	for i := range c.Jobs {
+		if c.Jobs[i].Name != name {
+			continue
+		}
+
+		j := &c.Jobs[i]
+		after := time.Now().In(c.loc)
+		times := make([]time.Time, 0, n)
+		for k := 0; k < n; k++ {
+			next := j.Next(after)
+			if next.IsZero() {
+				break
+			}
+			times = append(times, next)
+			after = next
+		}
+		return times, nil
+	}
+
+	return nil, fmt.Errorf("job %s not found", name)
+}
+
+// Entries returns the schedule and last/next fire time of every registered job
+func (c *Crontab) Entries() []Entry {
+	now := time.Now().In(c.loc)
+	entries := make([]Entry, 0, len(c.Jobs))
+	for i := range c.Jobs {
+		j := &c.Jobs[i]
+		entries = append(entries, Entry{
+			Name:     j.Name,
+			Schedule: j.Schedule,
+			Next:     j.Next(now),
+			Prev:     j.Prev,
+		})
+	}
+
+	return entries
+}
+
+// run the job through its wrapper chain
+//
+// There is no recover here anymore: a panicking job now crashes the whole
+// program unless a Recover wrapper is registered for it, see JobWrapper.
+// Error-aware jobs (see AddJobWithOptions) are the exception: baseFunc
+// recovers their panics itself, so that it can feed them to the backoff.
+func (j *Job) run() {
+	j.invoke()
+}
+
+// baseFunc returns the job's plain invocation, calling Fn with args via
+// reflection, ready to be decorated by the wrapper chain. If Fn returns an
+// error, baseFunc also recovers panics and reports both to j.state so
+// AddJobWithOptions's backoff policy can pause the job on failure.
+func (j *Job) baseFunc() func() {
+	fnType := reflect.TypeOf(j.Fn)
+	errAware := fnType.NumOut() == 1
+
+	return func() {
+		if errAware {
+			defer func() {
+				if r := recover(); r != nil {
+					j.state.recordResult(j.backoff, fmt.Errorf("panic: %v", r))
+				}
+			}()
+		}
+
+		v := reflect.ValueOf(j.Fn)
+		rargs := make([]reflect.Value, len(j.args))
+		for i, a := range j.args {
+			rargs[i] = reflect.ValueOf(a)
+		}
+		out := v.Call(rargs)
+
+		if !errAware {
+			return
+		}
+
+		var err error
+		if len(out) == 1 {
+			err, _ = out[0].Interface().(error)
+		}
+		j.state.recordResult(j.backoff, err)
+	}
 }
 
 // tick decides should the job be lauhcned at the tick
-func (j Job) tick(t tick) bool {
+func (j *Job) tick(t tick, now time.Time) bool {
+	if j.state.pausedUntil(now) {
+		return false
+	}
+
+	// `@every <duration>` jobs run their own clock instead of the field sets below
+	if j.every > 0 {
+		if now.After(j.nextAt) {
+			j.nextAt = now.Add(j.every)
+			return true
+		}
+		return false
+	}
+
+	if len(j.sec) > 0 {
+		if _, ok := j.sec[t.sec]; !ok {
+			return false
+		}
+	}
+
 	if _, ok := j.min[t.min]; !ok {
 		return false
 	}
@@ -234,9 +520,69 @@ func (j Job) tick(t tick) bool {
 		return false
 	}
 
+	// without a seconds field, fire once per matching minute even when the
+	// ticker runs every second for some other job
+	if len(j.sec) == 0 && !j.Prev.IsZero() && j.Prev.Truncate(time.Minute).Equal(now.Truncate(time.Minute)) {
+		return false
+	}
+
 	return true
 }
 
+// Next returns the next time the job is scheduled to run strictly after the
+// given instant, in after's location. It bounds the search to ~5 years so
+// an impossible schedule like "0 0 30 2 *" returns a zero time.Time instead
+// of looping forever.
+func (j *Job) Next(after time.Time) time.Time {
+	if j.every > 0 {
+		return after.Add(j.every)
+	}
+
+	step := time.Minute
+	if len(j.sec) > 0 {
+		step = time.Second
+	}
+
+	t := after.Truncate(step).Add(step)
+	horizon := after.AddDate(5, 0, 0)
+
+	for !t.After(horizon) {
+		if _, ok := j.month[int(t.Month())]; !ok {
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()).AddDate(0, 1, 0)
+			continue
+		}
+
+		// cummulative day and dayOfWeek, as it should be
+		_, day := j.day[t.Day()]
+		_, dayOfWeek := j.dayOfWeek[int(t.Weekday())]
+		if !day && !dayOfWeek {
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()).AddDate(0, 0, 1)
+			continue
+		}
+
+		if _, ok := j.hour[t.Hour()]; !ok {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location()).Add(time.Hour)
+			continue
+		}
+
+		if _, ok := j.min[t.Minute()]; !ok {
+			t = t.Truncate(time.Minute).Add(time.Minute)
+			continue
+		}
+
+		if len(j.sec) > 0 {
+			if _, ok := j.sec[t.Second()]; !ok {
+				t = t.Add(time.Second)
+				continue
+			}
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
 // regexps for parsing schedyle string
 var (
 	matchSpaces = regexp.MustCompile("\\s+")
@@ -245,13 +591,71 @@ var (
 )
 
 // parseSchedule string and creates job struct with filled times to launch, or error if synthax is wrong
+//
+// Besides the classic 5 field `* * * * *` syntax it also accepts:
+//
+// * a leading seconds field: `* * * * * *` (6 fields, seconds first)
+//
+// * the descriptor aliases `@yearly`/`@annually`, `@monthly`, `@weekly`, `@daily`/`@midnight`, `@hourly`
+//
+// * `@every <duration>`, parsed with time.ParseDuration, for fixed interval jobs
 func parseSchedule(s string) (j Job, err error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "@") {
+		return parseDescriptor(s)
+	}
+
 	s = matchSpaces.ReplaceAllLiteralString(s, " ")
 	parts := strings.Split(s, " ")
-	if len(parts) != 5 {
-		return Job{}, fmt.Errorf("schedule string must have five components like * * * * *, got %s", s)
+
+	switch len(parts) {
+	case 5:
+		return parseFields(parts)
+	case 6:
+		sec, err := parsePart(parts[0], 0, 59)
+		if err != nil {
+			return j, err
+		}
+		j, err = parseFields(parts[1:])
+		if err != nil {
+			return j, err
+		}
+		j.sec = sec
+		return j, nil
+	default:
+		return Job{}, fmt.Errorf("schedule string must have five components like * * * * * (or six with a leading seconds field), got %s", s)
 	}
+}
+
+// parseDescriptor handles the `@`-prefixed schedule shortcuts
+func parseDescriptor(s string) (Job, error) {
+	switch {
+	case s == "@yearly" || s == "@annually":
+		return parseSchedule("0 0 1 1 *")
+	case s == "@monthly":
+		return parseSchedule("0 0 1 * *")
+	case s == "@weekly":
+		return parseSchedule("0 0 * * 0")
+	case s == "@daily" || s == "@midnight":
+		return parseSchedule("0 0 * * *")
+	case s == "@hourly":
+		return parseSchedule("0 * * * *")
+	case strings.HasPrefix(s, "@every "):
+		d, err := time.ParseDuration(strings.TrimPrefix(s, "@every "))
+		if err != nil {
+			return Job{}, fmt.Errorf("parsing @every duration: %v", err)
+		}
+		if d <= 0 {
+			return Job{}, fmt.Errorf("@every duration must be positive, got %s", d)
+		}
+		return Job{every: d}, nil
+	default:
+		return Job{}, fmt.Errorf("unrecognized schedule descriptor %s", s)
+	}
+}
 
+// parseFields parses the classic 5 component min/hour/day/month/dayOfWeek schedule
+func parseFields(parts []string) (j Job, err error) {
 	j.min, err = parsePart(parts[0], 0, 59)
 	if err != nil {
 		return j, err
@@ -358,6 +762,7 @@ func parsePart(s string, min, max int) (map[int]struct{}, error) {
 // getTick returns the tick struct from time
 func getTick(t time.Time) tick {
 	return tick{
+		sec:       t.Second(),
 		min:       t.Minute(),
 		hour:      t.Hour(),
 		day:       t.Day(),
@@ -1,6 +1,9 @@
 package crontab
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 var schTest = []struct {
 	s   string
@@ -45,13 +48,179 @@ func TestSchedule(t *testing.T) {
 	}
 }
 
+var schTestSeconds = []struct {
+	s   string
+	cnt [6]int
+}{
+	{"* * * * * *", [6]int{60, 60, 24, 31, 12, 7}},
+	{"*/15 * * * * *", [6]int{4, 60, 24, 31, 12, 7}},
+	{"0,30 * * * * *", [6]int{2, 60, 24, 31, 12, 7}},
+}
+
+// TestScheduleSeconds parses the 6 component syntax and checks the leading seconds field
+// is picked up without disturbing the rest of the fields
+func TestScheduleSeconds(t *testing.T) {
+	for _, sch := range schTestSeconds {
+		j, err := parseSchedule(sch.s)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if len(j.sec) != sch.cnt[0] {
+			t.Error(sch.s, "sec count expected to be", sch.cnt[0], "result", len(j.sec), j.sec)
+		}
+
+		if len(j.min) != sch.cnt[1] {
+			t.Error(sch.s, "min count expected to be", sch.cnt[1], "result", len(j.min), j.min)
+		}
+
+		if len(j.hour) != sch.cnt[2] {
+			t.Error(sch.s, "hour count expected to be", sch.cnt[2], "result", len(j.hour), j.hour)
+		}
+
+		if len(j.day) != sch.cnt[3] {
+			t.Error(sch.s, "day count expected to be", sch.cnt[3], "result", len(j.day), j.day)
+		}
+
+		if len(j.month) != sch.cnt[4] {
+			t.Error(sch.s, "month count expected to be", sch.cnt[4], "result", len(j.month), j.month)
+		}
+
+		if len(j.dayOfWeek) != sch.cnt[5] {
+			t.Error(sch.s, "dayOfWeek count expected to be", sch.cnt[5], "result", len(j.dayOfWeek), j.dayOfWeek)
+		}
+	}
+}
+
+var schTestDescriptor = []struct {
+	s   string
+	cnt [5]int
+}{
+	{"@yearly", [5]int{1, 1, 1, 1, 0}},
+	{"@annually", [5]int{1, 1, 1, 1, 0}},
+	{"@monthly", [5]int{1, 1, 1, 12, 0}},
+	{"@weekly", [5]int{1, 1, 0, 12, 1}},
+	{"@daily", [5]int{1, 1, 31, 12, 7}},
+	{"@midnight", [5]int{1, 1, 31, 12, 7}},
+	{"@hourly", [5]int{1, 24, 31, 12, 7}},
+}
+
+// TestScheduleDescriptor checks the @-prefixed shortcuts expand to the expected field sets
+func TestScheduleDescriptor(t *testing.T) {
+	for _, sch := range schTestDescriptor {
+		j, err := parseSchedule(sch.s)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if len(j.min) != sch.cnt[0] {
+			t.Error(sch.s, "min count expected to be", sch.cnt[0], "result", len(j.min), j.min)
+		}
+
+		if len(j.hour) != sch.cnt[1] {
+			t.Error(sch.s, "hour count expected to be", sch.cnt[1], "result", len(j.hour), j.hour)
+		}
+
+		if len(j.day) != sch.cnt[2] {
+			t.Error(sch.s, "day count expected to be", sch.cnt[2], "result", len(j.day), j.day)
+		}
+
+		if len(j.month) != sch.cnt[3] {
+			t.Error(sch.s, "month count expected to be", sch.cnt[3], "result", len(j.month), j.month)
+		}
+
+		if len(j.dayOfWeek) != sch.cnt[4] {
+			t.Error(sch.s, "dayOfWeek count expected to be", sch.cnt[4], "result", len(j.dayOfWeek), j.dayOfWeek)
+		}
+	}
+}
+
+// TestScheduleEvery checks the `@every <duration>` form is parsed into a duration-based job
+func TestScheduleEvery(t *testing.T) {
+	j, err := parseSchedule("@every 1h30m")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if j.every != 90*time.Minute {
+		t.Error("@every 1h30m expected to parse as", 90*time.Minute, "got", j.every)
+	}
+
+	if _, err := parseSchedule("@every -5m"); err == nil {
+		t.Error("@every -5m should be an error, duration must be positive")
+	}
+
+	if _, err := parseSchedule("@every nope"); err == nil {
+		t.Error("@every nope should be an error, not a valid duration")
+	}
+}
+
+var jobNextTest = []struct {
+	schedule string
+	after    time.Time
+	want     time.Time
+}{
+	{"30 4 * * *", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 1, 1, 4, 30, 0, 0, time.UTC)},
+	{"0 0 1 * *", time.Date(2020, 1, 15, 0, 0, 0, 0, time.UTC), time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)},
+	{"0 0 30 2 *", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Time{}},
+}
+
+// TestJobNext checks Next walks forward to the expected fire time, and gives
+// up on schedules that can never match (e.g. February 30th)
+func TestJobNext(t *testing.T) {
+	for _, tt := range jobNextTest {
+		j, err := parseSchedule(tt.schedule)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got := j.Next(tt.after)
+		if tt.want.IsZero() {
+			if !got.IsZero() {
+				t.Error(tt.schedule, "expected no next run, got", got)
+			}
+			continue
+		}
+
+		if !got.Equal(tt.want) {
+			t.Error(tt.schedule, "expected next run at", tt.want, "got", got)
+		}
+	}
+}
+
+var backoffTest = []struct {
+	failCount int
+	want      time.Duration
+}{
+	{1, time.Minute},
+	{2, 2 * time.Minute},
+	{3, 4 * time.Minute},
+	{4, 8 * time.Minute},
+	{7, time.Hour},  // 1m*2^6 = 64m, capped at 1h
+	{20, time.Hour}, // way past the cap
+}
+
+// TestBackoffPolicy checks the default policy doubles on each consecutive
+// failure and saturates at its Max
+func TestBackoffPolicy(t *testing.T) {
+	var p BackoffPolicy
+	for _, tt := range backoffTest {
+		if got := p.Backoff(tt.failCount); got != tt.want {
+			t.Error("failCount", tt.failCount, "expected backoff", tt.want, "got", got)
+		}
+	}
+}
+
 var schErrorTest = []string{
-	"* * * * * *",
-	"1 2 3 4 5 6",
+	"* * * * * * *",
+	"60 2 3 4 5 6",
 	"*/ 2 * * * *",
 	"1,2,3/10 * * * *",
 	"1,2,3,1-15/10 * * * *",
 	"a b c d e",
+	"@every -5m",
+	"@every nope",
+	"@nonsense",
 }
 
 // TestScheduleError tests crontab syntax which should not be accepted
@@ -134,6 +134,51 @@ func TestRun(t *testing.T) {
 	}
 }
 
+func TestNextAndEntries(t *testing.T) {
+	ctab := crontab.New()
+	ctab.Start()
+
+	if err := ctab.AddJob("30 4 * * *", "asdf1", func() {}); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	next, err := ctab.Next("asdf1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !next.After(now) {
+		t.Error("Next() should return a time in the future, got", next)
+	}
+
+	nextN, err := ctab.NextN("asdf1", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nextN) != 3 {
+		t.Error("NextN(3) expected 3 fire times, got", len(nextN))
+	}
+	for i := 1; i < len(nextN); i++ {
+		if !nextN[i].After(nextN[i-1]) {
+			t.Error("NextN() times should be strictly increasing, got", nextN)
+		}
+	}
+
+	if _, err := ctab.Next("missing_job"); err == nil {
+		t.Error("Next() on missing job should return an error")
+	}
+
+	entries := ctab.Entries()
+	if len(entries) != 1 {
+		t.Fatal("Entries() expected 1 entry, got", len(entries))
+	}
+	if entries[0].Name != "asdf1" || entries[0].Schedule != "30 4 * * *" {
+		t.Error("Entries() returned unexpected entry", entries[0])
+	}
+
+	ctab.Shutdown()
+}
+
 func TestRunAll(t *testing.T) {
 	testN = 0
 	testS = ""
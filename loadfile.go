@@ -0,0 +1,164 @@
+package crontab
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// matchEnv recognises a Vixie-style `NAME=value` environment assignment line
+var matchEnv = regexp.MustCompile(`^([a-zA-Z_][a-zA-Z0-9_]*)=(.*)$`)
+
+// LoadFile opens path and loads it as a Vixie-style crontab, see LoadReader
+func (c *Crontab) LoadFile(path string, registry map[string]interface{}) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("opening crontab file: %v", err)
+	}
+	defer f.Close()
+
+	return c.LoadReader(f, registry)
+}
+
+// LoadReader parses a Vixie-style crontab read from r and adds the job each
+// line defines
+//
+// Blank lines and lines starting with # are skipped. A `NAME=value` line
+// doesn't add a job; it sets an environment variable retrievable later with
+// Env. Every other line is `<schedule> [args...] <fn>`, where schedule is
+// the usual 5 field crontab syntax or one of the @ descriptors, fn is a key
+// into registry, and any args are double-quoted strings passed to fn as-is.
+//
+// A job's Name defaults to its registry key, with a `-2`, `-3`, ... suffix
+// appended when the same key is bound more than once in the file.
+func (c *Crontab) LoadReader(r io.Reader, registry map[string]interface{}) error {
+	counts := make(map[string]int)
+
+	scanner := bufio.NewScanner(r)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if m := matchEnv.FindStringSubmatch(line); m != nil {
+			c.setEnv(m[1], m[2])
+			continue
+		}
+
+		schedule, command, err := splitScheduleAndCommand(line)
+		if err != nil {
+			return fmt.Errorf("line %d: %v", lineNo, err)
+		}
+
+		if len(command) == 0 {
+			return fmt.Errorf("line %d: missing command", lineNo)
+		}
+
+		key := command[len(command)-1]
+		fn, ok := registry[key]
+		if !ok {
+			return fmt.Errorf("line %d: %s not found in registry", lineNo, key)
+		}
+
+		argTokens := command[:len(command)-1]
+		args := make([]interface{}, len(argTokens))
+		for i, a := range argTokens {
+			args[i] = unquote(a)
+		}
+
+		counts[key]++
+		name := key
+		if n := counts[key]; n > 1 {
+			name = fmt.Sprintf("%s-%d", key, n)
+		}
+
+		if err := c.AddJob(schedule, name, fn, args...); err != nil {
+			return fmt.Errorf("line %d: %v", lineNo, err)
+		}
+	}
+
+	return scanner.Err()
+}
+
+// splitScheduleAndCommand separates a crontab line's schedule (5 fields, a
+// single @ descriptor, or `@every <duration>`) from its trailing command tokens
+func splitScheduleAndCommand(line string) (schedule string, command []string, err error) {
+	tokens := fieldsPreservingQuotes(line)
+
+	if tokens[0] == "@every" {
+		if len(tokens) < 3 {
+			return "", nil, fmt.Errorf("expected a duration and a command after @every, got %q", line)
+		}
+		return strings.Join(tokens[:2], " "), tokens[2:], nil
+	}
+
+	if strings.HasPrefix(tokens[0], "@") {
+		return tokens[0], tokens[1:], nil
+	}
+
+	if len(tokens) < 6 {
+		return "", nil, fmt.Errorf("expected a 5 field schedule and a command, got %q", line)
+	}
+
+	return strings.Join(tokens[:5], " "), tokens[5:], nil
+}
+
+// fieldsPreservingQuotes splits s on whitespace like strings.Fields, except a
+// double-quoted span is kept as one token even if it contains spaces, so a
+// quoted arg such as "hello world" survives intact for unquote to unwrap
+func fieldsPreservingQuotes(s string) []string {
+	var tokens []string
+	var field strings.Builder
+	inQuotes := false
+
+	for _, r := range s {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			field.WriteRune(r)
+		case unicode.IsSpace(r) && !inQuotes:
+			if field.Len() > 0 {
+				tokens = append(tokens, field.String())
+				field.Reset()
+			}
+		default:
+			field.WriteRune(r)
+		}
+	}
+	if field.Len() > 0 {
+		tokens = append(tokens, field.String())
+	}
+
+	return tokens
+}
+
+// unquote strips a single layer of surrounding double quotes, if present
+func unquote(s string) string {
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// setEnv records a NAME=value assignment loaded from a crontab file
+func (c *Crontab) setEnv(name, value string) {
+	c.mu.Lock()
+	if c.env == nil {
+		c.env = make(map[string]string)
+	}
+	c.env[name] = value
+	c.mu.Unlock()
+}
+
+// Env returns the value of a NAME=value assignment loaded via LoadFile or
+// LoadReader, or the empty string if name was never set
+func (c *Crontab) Env(name string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.env[name]
+}
@@ -0,0 +1,130 @@
+package crontab_test
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shved/crontab"
+)
+
+func TestLoadReader(t *testing.T) {
+	const file = `
+# a comment, and a blank line above
+
+MAILTO=ops@example.com
+
+* * * * * "hello" greet
+@hourly "hi" greet
+0 0 * * * cleanup
+`
+
+	var mu sync.Mutex
+	var greeted []string
+	cleaned := 0
+	registry := map[string]interface{}{
+		"greet": func(s string) {
+			mu.Lock()
+			greeted = append(greeted, s)
+			mu.Unlock()
+		},
+		"cleanup": func() {
+			mu.Lock()
+			cleaned++
+			mu.Unlock()
+		},
+	}
+
+	ctab := crontab.New()
+	if err := ctab.LoadReader(strings.NewReader(file), registry); err != nil {
+		t.Fatal(err)
+	}
+
+	if ctab.Env("MAILTO") != "ops@example.com" {
+		t.Error("expected MAILTO to be loaded from the file, got", ctab.Env("MAILTO"))
+	}
+
+	names := ctab.List()
+	want := map[string]bool{"greet": true, "greet-2": true, "cleanup": true}
+	if len(names) != len(want) {
+		t.Fatal("expected jobs", want, "got", names)
+	}
+	for _, n := range names {
+		if !want[n] {
+			t.Error("unexpected job name", n)
+		}
+	}
+
+	ctab.RunAll()
+	time.Sleep(100 * time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(greeted) != 2 {
+		t.Fatal("expected both greet jobs to run, got", greeted)
+	}
+	gotArgs := map[string]bool{greeted[0]: true, greeted[1]: true}
+	if !gotArgs["hello"] || !gotArgs["hi"] {
+		t.Error("expected greet to be called with its quoted args, got", greeted)
+	}
+	if cleaned != 1 {
+		t.Error("expected cleanup to run once, got", cleaned)
+	}
+}
+
+func TestLoadReaderEvery(t *testing.T) {
+	registry := map[string]interface{}{"cleanup": func() {}}
+
+	ctab := crontab.New()
+	if err := ctab.LoadReader(strings.NewReader("@every 5s cleanup"), registry); err != nil {
+		t.Fatal(err)
+	}
+
+	entries := ctab.Entries()
+	if len(entries) != 1 || entries[0].Name != "cleanup" {
+		t.Fatal("expected one cleanup job, got", entries)
+	}
+}
+
+func TestLoadReaderQuotedArgWithSpace(t *testing.T) {
+	var mu sync.Mutex
+	var got string
+	registry := map[string]interface{}{
+		"greet": func(s string) {
+			mu.Lock()
+			got = s
+			mu.Unlock()
+		},
+	}
+
+	ctab := crontab.New()
+	if err := ctab.LoadReader(strings.NewReader(`* * * * * "hello world" greet`), registry); err != nil {
+		t.Fatal(err)
+	}
+
+	ctab.RunAll()
+	time.Sleep(100 * time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if got != "hello world" {
+		t.Error(`expected greet to be called with "hello world", got`, got)
+	}
+}
+
+func TestLoadReaderErrors(t *testing.T) {
+	registry := map[string]interface{}{"greet": func() {}}
+
+	cases := []string{
+		"* * * * * unknownFn",
+		"* * *",
+	}
+
+	for _, c := range cases {
+		ctab := crontab.New()
+		if err := ctab.LoadReader(strings.NewReader(c), registry); err == nil {
+			t.Error("expected an error loading", c)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package crontab_test
+
+import (
+	"io/ioutil"
+	"log"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shved/crontab"
+)
+
+func testLogger() *log.Logger {
+	return log.New(ioutil.Discard, "", 0)
+}
+
+func TestChain(t *testing.T) {
+	var calls []string
+	record := func(name string) crontab.JobWrapper {
+		return func(fn func()) func() {
+			return func() {
+				calls = append(calls, name)
+				fn()
+			}
+		}
+	}
+
+	chain := crontab.NewChain(record("a"), record("b"))
+	chain.Then(func() { calls = append(calls, "fn") })()
+
+	want := []string{"a", "b", "fn"}
+	if len(calls) != len(want) {
+		t.Fatalf("expected %v, got %v", want, calls)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, calls)
+		}
+	}
+}
+
+func TestRecover(t *testing.T) {
+	fn := crontab.Recover(testLogger())(func() {
+		panic("boom")
+	})
+
+	done := make(chan struct{})
+	go func() {
+		fn()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Recover did not stop the panic from escaping the goroutine")
+	}
+}
+
+func TestSkipIfStillRunning(t *testing.T) {
+	var ran int
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	release := make(chan struct{})
+	fn := crontab.SkipIfStillRunning(testLogger())(func() {
+		ran++
+		wg.Done()
+		<-release
+	})
+
+	go fn()
+	wg.Wait()
+
+	// second invocation should be skipped while the first is still blocked
+	fn()
+	close(release)
+	time.Sleep(50 * time.Millisecond)
+
+	if ran != 1 {
+		t.Error("expected the job to run exactly once while overlapping, got", ran)
+	}
+}
+
+func TestDelayIfStillRunning(t *testing.T) {
+	var mu sync.Mutex
+	var order []string
+
+	release := make(chan struct{})
+	fn := crontab.DelayIfStillRunning(testLogger())(func() {
+		mu.Lock()
+		order = append(order, "start")
+		mu.Unlock()
+		<-release
+		mu.Lock()
+		order = append(order, "end")
+		mu.Unlock()
+	})
+
+	started := make(chan struct{})
+	go func() {
+		close(started)
+		fn()
+	}()
+	<-started
+	time.Sleep(20 * time.Millisecond) // give the first call time to grab the lock
+
+	second := make(chan struct{})
+	go func() {
+		fn()
+		close(second)
+	}()
+
+	select {
+	case <-second:
+		t.Fatal("second invocation returned before the first one finished")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+
+	select {
+	case <-second:
+	case <-time.After(time.Second):
+		t.Fatal("second invocation never unblocked after the first one finished")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	want := []string{"start", "end", "start", "end"}
+	if len(order) != len(want) {
+		t.Fatal("expected the two runs to stay sequential, got", order)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Error("expected the two runs to stay sequential, got", order)
+			break
+		}
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	done := make(chan struct{})
+	fn := crontab.WithTimeout(10 * time.Millisecond)(func() {
+		<-done
+	})
+
+	start := time.Now()
+	fn()
+	if elapsed := time.Since(start); elapsed > 200*time.Millisecond {
+		t.Error("WithTimeout should abandon the job around the deadline, took", elapsed)
+	}
+	close(done)
+}
+
+func TestAddJobWithOptions(t *testing.T) {
+	ctab := crontab.New()
+	ctab.Start()
+
+	var calls int
+	wg := make(chan struct{})
+	opts := crontab.Options{Wrappers: []crontab.JobWrapper{crontab.SkipIfStillRunning(testLogger())}}
+	if err := ctab.AddJobWithOptions("* * * * *", "asdf1", func() { calls++; close(wg) }, opts); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ctab.Run("asdf1"); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-wg:
+	case <-time.After(time.Second):
+		t.Fatal("job added via AddJobWithOptions never ran")
+	}
+
+	ctab.Shutdown()
+}
+
+func TestUse(t *testing.T) {
+	ctab := crontab.New()
+	ctab.Start()
+
+	var applied bool
+	ctab.Use(func(fn func()) func() {
+		return func() {
+			applied = true
+			fn()
+		}
+	})
+
+	done := make(chan struct{})
+	if err := ctab.AddJob("* * * * *", "useme", func() { close(done) }); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ctab.Run("useme"); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("job added after Use never ran")
+	}
+
+	if !applied {
+		t.Error("expected the Use-registered wrapper to be applied to the job's invocation chain")
+	}
+
+	ctab.Shutdown()
+}